@@ -0,0 +1,185 @@
+package hue
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+)
+
+// lightGroupThreshold is the minimum number of lights sharing an
+// identical state before SetLightsState bothers creating a temporary
+// group for them, rather than issuing one PUT per light.
+const lightGroupThreshold = 3
+
+// tempGroup is the body posted to /groups to create a scratch group for
+// batching a fan-out light update.
+type tempGroup struct {
+	Type    string   `json:"type"`
+	Lights  []string `json:"lights"`
+	Recycle bool     `json:"recycle"`
+}
+
+// SetLightsState applies states to many lights in as few requests as
+// possible, returning each light's Result keyed by its ID. The bridge
+// serializes per-light PUTs at roughly 10 requests/second but executes
+// a group action in a single command, so when at least
+// lightGroupThreshold lights share an identical SetLightState, those
+// lights are grouped into one temporary Hue group (POST /groups with
+// "recycle":true) and updated with a single PUT; everything else - and
+// everything, if DisableBatching was called - falls back to individual
+// per-light PUTs issued in parallel.
+func (b *Bridge) SetLightsState(states map[string]SetLightState) (map[string][]Result, error) {
+	results := make(map[string][]Result, len(states))
+
+	if b.noBatch {
+		return results, b.setLightsStateIndividually(states, results)
+	}
+
+	buckets := make(map[string][]string)
+	bucketState := make(map[string]SetLightState)
+	for id, state := range states {
+		data, err := json.Marshal(state)
+		if err != nil {
+			return results, err
+		}
+
+		key := string(data)
+		buckets[key] = append(buckets[key], id)
+		bucketState[key] = state
+	}
+
+	singles := make(map[string]SetLightState)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(chan error, len(buckets))
+
+	for key, lightIDs := range buckets {
+		if len(lightIDs) < lightGroupThreshold {
+			for _, id := range lightIDs {
+				singles[id] = bucketState[key]
+			}
+			continue
+		}
+
+		wg.Add(1)
+		go func(lightIDs []string, state SetLightState) {
+			defer wg.Done()
+
+			groupResults, err := b.setTempGroupState(lightIDs, state)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			mu.Lock()
+			for _, id := range lightIDs {
+				results[id] = groupResults
+			}
+			mu.Unlock()
+		}(lightIDs, bucketState[key])
+	}
+
+	wg.Wait()
+	close(errs)
+
+	// Always attempt the singleton PUTs, even if a batch bucket failed -
+	// one bad/flaky group shouldn't cancel unrelated per-light updates.
+	singlesErr := b.setLightsStateIndividually(singles, results)
+
+	if err, ok := <-errs; ok {
+		return results, err
+	}
+
+	return results, singlesErr
+}
+
+// setLightsStateIndividually issues one PUT per light in parallel and
+// stores each light's Result into results.
+func (b *Bridge) setLightsStateIndividually(states map[string]SetLightState, results map[string][]Result) error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(chan error, len(states))
+
+	for id, state := range states {
+		wg.Add(1)
+		go func(id string, state SetLightState) {
+			defer wg.Done()
+
+			r, err := b.setLightState(id, state)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			mu.Lock()
+			results[id] = r
+			mu.Unlock()
+		}(id, state)
+	}
+
+	wg.Wait()
+	close(errs)
+	if err, ok := <-errs; ok {
+		return err
+	}
+
+	return nil
+}
+
+// setLightState PUTs state to a single light, as per
+// http://developers.meethue.com/1_lightsapi.html#16_set_light_state.
+func (b *Bridge) setLightState(id string, state SetLightState) ([]Result, error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := b.put("/lights/"+id+"/state", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
+
+// setTempGroupState creates a recyclable temporary group containing
+// lightIDs, applies state to it with a single PUT, and deletes the
+// group again (recycle:true also lets the bridge reclaim it on its
+// own, but we don't rely on that alone).
+func (b *Bridge) setTempGroupState(lightIDs []string, state SetLightState) ([]Result, error) {
+	data, err := json.Marshal(tempGroup{Type: "LightGroup", Lights: lightIDs, Recycle: true})
+	if err != nil {
+		return nil, err
+	}
+
+	groupID, err := b.postForID("/groups", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if response, err := b.delete("/groups/" + groupID); err == nil {
+			response.Body.Close()
+		}
+	}()
+
+	return b.SetGroupState(groupID, GroupState{
+		On:             state.On,
+		Bri:            state.Bri,
+		Hue:            state.Hue,
+		Sat:            state.Sat,
+		Xy:             state.Xy,
+		Ct:             state.Ct,
+		BriInc:         state.BriInc,
+		SatInc:         state.SatInc,
+		HueInc:         state.HueInc,
+		CtInc:          state.CtInc,
+		XyInc:          state.XyInc,
+		Alert:          state.Alert,
+		Effect:         state.Effect,
+		TransitionTime: state.TransitionTime,
+		Scene:          state.Scene,
+	})
+}
@@ -1,6 +1,7 @@
 package hue
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"time"
 )
 
 // Bridge is a representation of the Philips Hue bridge device.
@@ -15,6 +17,12 @@ type Bridge struct {
 	IPAddr   string
 	Username string
 	debug    bool
+	noBatch  bool
+
+	// PollInterval controls how often Subscribe polls the bridge for
+	// changes when it falls back to polling. Defaults to one second
+	// when left zero.
+	PollInterval time.Duration
 }
 
 // NewBridge instantiates a bridge object.  Use this method when you already
@@ -29,10 +37,24 @@ func (b *Bridge) Debug() *Bridge {
 	return b
 }
 
+// DisableBatching turns off the temporary-group batching SetLightsState
+// otherwise applies, so it always issues one PUT per light. Use this
+// when you need a reliable per-light Result for every target.
+func (b *Bridge) DisableBatching() *Bridge {
+	b.noBatch = true
+	return b
+}
+
 func (b *Bridge) toURI(path string) string {
 	return fmt.Sprintf("http://%s/api/%s%s", b.IPAddr, b.Username, path)
 }
 
+// toNoAuthURI builds a URI for the handful of endpoints (currently just
+// user creation) that are called before a username exists.
+func (b *Bridge) toNoAuthURI(path string) string {
+	return fmt.Sprintf("http://%s/api%s", b.IPAddr, path)
+}
+
 func (b *Bridge) get(path string) (*http.Response, error) {
 	uri := b.toURI(path)
 	if b.debug {
@@ -62,6 +84,47 @@ func (b *Bridge) put(path string, body io.Reader) (*http.Response, error) {
 	return client.Do(request)
 }
 
+func (b *Bridge) delete(path string) (*http.Response, error) {
+	uri := b.toURI(path)
+	if b.debug {
+		log.Printf("DELETE %s\n", uri)
+	}
+	request, err := http.NewRequest("DELETE", uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(request)
+}
+
+// createIDResult is the single-element response shape the bridge returns
+// from most POST (create) calls: [{"success":{"id":"<new id>"}}].
+type createIDResult struct {
+	Success struct {
+		ID string `json:"id"`
+	} `json:"success"`
+}
+
+// postForID posts body to path and returns the id the bridge assigned to
+// the newly created resource.
+func (b *Bridge) postForID(path string, body io.Reader) (string, error) {
+	response, err := b.post(path, body)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	var results []createIDResult
+	if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+		return "", err
+	}
+	if len(results) == 0 {
+		return "", errors.New("hue: empty response from bridge")
+	}
+
+	return results[0].Success.ID, nil
+}
+
 // GetNewLights - retrieves the list lights we've seen since
 // the last scan.  returns the new lights, lastseen, and any error
 // that may have occured as per:
@@ -170,3 +233,83 @@ func (b *Bridge) GetAllLights() ([]*Light, error) {
 
 	return lights, nil
 }
+
+// errTypeLinkButtonNotPressed is the CLIP error type returned while the
+// physical link button on the bridge hasn't been pressed yet.
+const errTypeLinkButtonNotPressed = 101
+
+// createUserResult mirrors the one-element array the bridge returns from
+// an unauthenticated POST /api, which differs from the [success/error]
+// shape used everywhere else (the success object here isn't a map of
+// changed paths, it's a single username).
+type createUserResult struct {
+	Success *struct {
+		Username string `json:"username"`
+	} `json:"success"`
+	Error *struct {
+		Type        int    `json:"type"`
+		Description string `json:"description"`
+	} `json:"error"`
+}
+
+// createUserPollInterval is how often CreateUser retries while waiting
+// for the link button to be pressed.
+const createUserPollInterval = time.Second
+
+// CreateUser registers appName with the bridge as per
+// http://developers.meethue.com/1_gettingstarted.html, storing the
+// resulting username on the Bridge for use by subsequent calls. The
+// bridge requires its physical link button to be pressed within
+// timeout of the call; CreateUser polls every second until that
+// happens, timeout elapses, or the bridge returns an error other than
+// "link button not pressed".
+func (b *Bridge) CreateUser(appName string, timeout time.Duration) error {
+	data, err := json.Marshal(map[string]string{"devicetype": appName})
+	if err != nil {
+		return err
+	}
+
+	uri := b.toNoAuthURI("")
+	deadline := time.Now().Add(timeout)
+
+	for {
+		if b.debug {
+			log.Printf("POST %s\n", uri)
+		}
+
+		response, err := client.Post(uri, "application/json", bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+
+		var results []createUserResult
+		err = json.NewDecoder(response.Body).Decode(&results)
+		response.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if len(results) == 0 {
+			return errors.New("hue: empty response from bridge")
+		}
+
+		if results[0].Success != nil {
+			b.Username = results[0].Success.Username
+			return nil
+		}
+
+		if results[0].Error == nil {
+			return errors.New("hue: malformed response from bridge")
+		}
+
+		if results[0].Error.Type != errTypeLinkButtonNotPressed {
+			return errors.New("hue: " + results[0].Error.Description)
+		}
+
+		if time.Now().After(deadline) {
+			return errors.New("hue: timed out waiting for link button press")
+		}
+
+		time.Sleep(createUserPollInterval)
+	}
+}
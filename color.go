@@ -0,0 +1,253 @@
+package hue
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Gamut describes the triangular range of CIE xy chromaticities a Hue
+// bulb model can reproduce, as the xy coordinates of its red, green,
+// and blue primaries.
+type Gamut struct {
+	Red, Green, Blue [2]float32
+}
+
+// The three color gamuts Philips has shipped bulbs with. Gamut A covers
+// the original 2012 LCT001-era bulbs, Gamut B the early LivingColors
+// (LLC-series) bulbs, and Gamut C the 2015-onward LCT010+ bulbs.
+var (
+	GamutA = Gamut{Red: [2]float32{0.703, 0.296}, Green: [2]float32{0.214, 0.709}, Blue: [2]float32{0.139, 0.081}}
+	GamutB = Gamut{Red: [2]float32{0.675, 0.322}, Green: [2]float32{0.409, 0.518}, Blue: [2]float32{0.167, 0.040}}
+	GamutC = Gamut{Red: [2]float32{0.692, 0.308}, Green: [2]float32{0.170, 0.700}, Blue: [2]float32{0.153, 0.048}}
+)
+
+// gamutByModelID maps LightAttributes.ModelID to the gamut that model
+// supports, per Philips' published per-model gamut list.
+var gamutByModelID = map[string]Gamut{
+	"LCT001": GamutA, "LCT002": GamutA, "LCT003": GamutA, "LCT007": GamutA,
+
+	"LLC001": GamutB, "LLC005": GamutB, "LLC006": GamutB, "LLC007": GamutB,
+	"LLC010": GamutB, "LLC011": GamutB, "LLC012": GamutB, "LLC013": GamutB,
+	"LLC014": GamutB, "LST001": GamutB,
+
+	"LCT010": GamutC, "LCT011": GamutC, "LCT012": GamutC, "LCT014": GamutC,
+	"LCT015": GamutC, "LCT016": GamutC, "LLC020": GamutC, "LST002": GamutC,
+}
+
+// GamutForModel looks up the color gamut for a bulb's ModelID, falling
+// back to Gamut B for models we don't recognize.
+func GamutForModel(modelID string) Gamut {
+	if gamut, ok := gamutByModelID[modelID]; ok {
+		return gamut
+	}
+	return GamutB
+}
+
+// RGBToXY converts c into the CIE xy chromaticity and brightness Hue's
+// Xy/Bri fields expect, clamping the result into gamut so it's always a
+// point the targeted bulb can actually reproduce. It applies the
+// standard sRGB gamma correction followed by the Wide RGB D65 to XYZ
+// matrix, as documented at http://developers.meethue.com/likely-gone
+// (archived in the Hue color conversion guide).
+func RGBToXY(c color.Color, gamut Gamut) (x, y float32, bri uint8) {
+	r, g, b, _ := c.RGBA()
+
+	rl := srgbToLinear(float64(r) / 0xffff)
+	gl := srgbToLinear(float64(g) / 0xffff)
+	bl := srgbToLinear(float64(b) / 0xffff)
+
+	X := rl*0.649926 + gl*0.103455 + bl*0.197109
+	Y := rl*0.234327 + gl*0.743075 + bl*0.022598
+	Z := gl*0.053077 + bl*1.035763
+
+	var px, py float32
+	if sum := X + Y + Z; sum > 0 {
+		px, py = float32(X/sum), float32(Y/sum)
+	}
+
+	px, py = clampToGamut(px, py, gamut)
+
+	return px, py, uint8(math.Round(Y * 255))
+}
+
+// XYToRGB converts a CIE xy point and brightness back into sRGB, the
+// inverse of RGBToXY.
+func XYToRGB(x, y float32, bri uint8) color.RGBA {
+	if y == 0 {
+		return color.RGBA{A: 0xff}
+	}
+
+	Y := float64(bri) / 255
+	X := Y / float64(y) * float64(x)
+	Z := Y / float64(y) * float64(1-x-y)
+
+	r := X*1.611757 - Y*0.202805 - Z*0.302298
+	g := -X*0.509057 + Y*1.411914 + Z*0.066070
+	b := X*0.026086 - Y*0.072353 + Z*0.962086
+
+	return color.RGBA{
+		R: linearToSRGB8(r),
+		G: linearToSRGB8(g),
+		B: linearToSRGB8(b),
+		A: 0xff,
+	}
+}
+
+// HexToXY parses a "#rrggbb" or "rrggbb" hex color string and converts
+// it into the CIE xy chromaticity and brightness Hue's Xy/Bri fields
+// expect, as per RGBToXY.
+func HexToXY(hex string, gamut Gamut) (x, y float32, bri uint8, err error) {
+	r, g, b, err := hexToRGB(hex)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	x, y, bri = RGBToXY(color.RGBA{R: r, G: g, B: b, A: 0xff}, gamut)
+	return x, y, bri, nil
+}
+
+// XYToHex converts a CIE xy point and brightness into a "#rrggbb" hex
+// color string, the inverse of HexToXY.
+func XYToHex(x, y float32, bri uint8) string {
+	c := XYToRGB(x, y, bri)
+	return rgbToHex(c.R, c.G, c.B)
+}
+
+// hexToRGB parses a "#rrggbb" or "rrggbb" hex color string.
+func hexToRGB(hex string) (r, g, b uint8, err error) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return 0, 0, 0, fmt.Errorf("hue: %q is not a 6-digit hex color", hex)
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("hue: %q is not a valid hex color: %w", hex, err)
+	}
+
+	return uint8(value >> 16), uint8(value >> 8), uint8(value), nil
+}
+
+// rgbToHex formats r, g, b as a "#rrggbb" hex color string.
+func rgbToHex(r, g, b uint8) string {
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// RGB converts a light's reported Xy and Bri back into sRGB, using
+// gamut to interpret the coordinates (see GamutForModel).
+func (s LightState) RGB(gamut Gamut) color.RGBA {
+	var x, y float32
+	if len(s.Xy) == 2 {
+		x, y = clampToGamut(s.Xy[0], s.Xy[1], gamut)
+	}
+
+	return XYToRGB(x, y, uint8(s.Bri))
+}
+
+func srgbToLinear(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB8(c float64) uint8 {
+	if c <= 0 {
+		return 0
+	}
+	if c <= 0.0031308 {
+		c *= 12.92
+	} else {
+		c = 1.055*math.Pow(c, 1/2.4) - 0.055
+	}
+	if c >= 1 {
+		return 255
+	}
+	return uint8(math.Round(c * 255))
+}
+
+// clampToGamut projects (x, y) onto the nearest edge of gamut's
+// triangle when the point falls outside it, leaving in-gamut points
+// untouched.
+func clampToGamut(x, y float32, gamut Gamut) (float32, float32) {
+	if pointInTriangle(x, y, gamut.Red, gamut.Green, gamut.Blue) {
+		return x, y
+	}
+
+	candidates := [3][2]float32{
+		closestPointOnSegment(x, y, gamut.Red, gamut.Green),
+		closestPointOnSegment(x, y, gamut.Green, gamut.Blue),
+		closestPointOnSegment(x, y, gamut.Blue, gamut.Red),
+	}
+
+	closest := candidates[0]
+	closestDist := distSq(x, y, closest)
+	for _, candidate := range candidates[1:] {
+		if d := distSq(x, y, candidate); d < closestDist {
+			closest, closestDist = candidate, d
+		}
+	}
+
+	return closest[0], closest[1]
+}
+
+func sign(p, a, b [2]float32) float32 {
+	return (p[0]-b[0])*(a[1]-b[1]) - (a[0]-b[0])*(p[1]-b[1])
+}
+
+func pointInTriangle(x, y float32, a, b, c [2]float32) bool {
+	p := [2]float32{x, y}
+	d1, d2, d3 := sign(p, a, b), sign(p, b, c), sign(p, c, a)
+
+	hasNeg := d1 < 0 || d2 < 0 || d3 < 0
+	hasPos := d1 > 0 || d2 > 0 || d3 > 0
+
+	return !(hasNeg && hasPos)
+}
+
+// closestPointOnSegment returns the point on segment a-b nearest
+// (x, y), via the standard point-to-segment projection formula.
+func closestPointOnSegment(x, y float32, a, b [2]float32) [2]float32 {
+	abx, aby := b[0]-a[0], b[1]-a[1]
+
+	lengthSq := abx*abx + aby*aby
+	if lengthSq == 0 {
+		return a
+	}
+
+	t := ((x-a[0])*abx + (y-a[1])*aby) / lengthSq
+	if t < 0 {
+		t = 0
+	} else if t > 1 {
+		t = 1
+	}
+
+	return [2]float32{a[0] + t*abx, a[1] + t*aby}
+}
+
+func distSq(x, y float32, p [2]float32) float32 {
+	dx, dy := x-p[0], y-p[1]
+	return dx*dx + dy*dy
+}
+
+// KelvinToMired converts a color temperature in Kelvin to the mired
+// value Hue's Ct field expects, clamped to the 153-500 range
+// (6500K-2000K) the bridge documents as its supported span.
+func KelvinToMired(k int) uint16 {
+	if k < 1 {
+		k = 1
+	}
+
+	mired := 1000000 / k
+	switch {
+	case mired < 153:
+		mired = 153
+	case mired > 500:
+		mired = 500
+	}
+
+	return uint16(mired)
+}
@@ -0,0 +1,39 @@
+package hue
+
+import (
+	"image/color"
+	"testing"
+)
+
+// TestRGBRoundTrip checks that converting RGB to CIE xy and back stays
+// close to the original color - a regression test for the forward and
+// reverse matrices being true inverses of each other. Cases are chosen
+// to fall inside GamutC so gamut clamping (which is intentionally lossy
+// for colors a bulb can't reproduce) doesn't mask a broken matrix.
+func TestRGBRoundTrip(t *testing.T) {
+	const delta = 2
+
+	cases := []color.RGBA{
+		{R: 255, G: 255, B: 255, A: 0xff},
+		{R: 200, G: 150, B: 100, A: 0xff},
+		{R: 100, G: 200, B: 150, A: 0xff},
+		{R: 150, G: 100, B: 200, A: 0xff},
+		{R: 255, G: 180, B: 80, A: 0xff},
+	}
+
+	for _, c := range cases {
+		x, y, bri := RGBToXY(c, GamutC)
+		got := XYToRGB(x, y, bri)
+
+		if absDiff(got.R, c.R) > delta || absDiff(got.G, c.G) > delta || absDiff(got.B, c.B) > delta {
+			t.Errorf("RGBToXY(%v) -> XYToRGB round-tripped to %v, want within %d of original", c, got, delta)
+		}
+	}
+}
+
+func absDiff(a, b uint8) int {
+	if a > b {
+		return int(a - b)
+	}
+	return int(b - a)
+}
@@ -0,0 +1,91 @@
+package hue
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// WhitelistEntry describes one authorized application/username pair in
+// the bridge's whitelist, as returned within BridgeConfig.
+type WhitelistEntry struct {
+	Name        string `json:"name"`
+	CreateDate  string `json:"create date"`
+	LastUseDate string `json:"last use date"`
+}
+
+// BridgeConfig is the bridge-wide configuration exposed at
+// /api/<username>/config, as per
+// http://developers.meethue.com/11_configuration_api.html#72_get_configuration.
+type BridgeConfig struct {
+	Name             string                    `json:"name"`
+	ZigbeeChannel    int                       `json:"zigbeechannel"`
+	MAC              string                    `json:"mac"`
+	DHCP             bool                      `json:"dhcp"`
+	IPAddress        string                    `json:"ipaddress"`
+	Netmask          string                    `json:"netmask"`
+	Gateway          string                    `json:"gateway"`
+	ProxyAddress     string                    `json:"proxyaddress"`
+	ProxyPort        int                       `json:"proxyport"`
+	UTC              string                    `json:"UTC"`
+	LocalTime        string                    `json:"localtime"`
+	TimeZone         string                    `json:"timezone"`
+	ModelID          string                    `json:"modelid"`
+	DataStoreVersion string                    `json:"datastoreversion"`
+	SoftwareVersion  string                    `json:"swversion"`
+	APIVersion       string                    `json:"apiversion"`
+	LinkButton       bool                      `json:"linkbutton"`
+	PortalServices   bool                      `json:"portalservices"`
+	PortalConnection string                    `json:"portalconnection"`
+	BridgeID         string                    `json:"bridgeid"`
+	FactoryNew       bool                      `json:"factorynew"`
+	Whitelist        map[string]WhitelistEntry `json:"whitelist"`
+}
+
+// GetConfig retrieves the bridge's configuration as per
+// http://developers.meethue.com/11_configuration_api.html#72_get_configuration.
+func (b *Bridge) GetConfig() (*BridgeConfig, error) {
+	response, err := b.get("/config")
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	config := new(BridgeConfig)
+	err = json.NewDecoder(response.Body).Decode(config)
+	return config, err
+}
+
+// ConfigUpdate carries the subset of bridge configuration fields that
+// can be changed via UpdateConfig. Zero-valued fields are omitted from
+// the request, so only set the fields you want changed.
+type ConfigUpdate struct {
+	Name         string `json:"name,omitempty"`
+	TimeZone     string `json:"timezone,omitempty"`
+	IPAddress    string `json:"ipaddress,omitempty"`
+	Netmask      string `json:"netmask,omitempty"`
+	Gateway      string `json:"gateway,omitempty"`
+	ProxyAddress string `json:"proxyaddress,omitempty"`
+	ProxyPort    int    `json:"proxyport,omitempty"`
+	DHCP         *bool  `json:"dhcp,omitempty"`
+	LinkButton   *bool  `json:"linkbutton,omitempty"`
+	Touchlink    *bool  `json:"touchlink,omitempty"`
+}
+
+// UpdateConfig modifies the bridge's configuration as per
+// http://developers.meethue.com/11_configuration_api.html#73_modify_configuration.
+func (b *Bridge) UpdateConfig(update ConfigUpdate) ([]Result, error) {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := b.put("/config", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
@@ -0,0 +1,117 @@
+package hue
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net"
+	"time"
+)
+
+// nupnpEndpoint is Philips' cloud-hosted discovery service. It's backed
+// by the bridges' portal connections, so it only finds bridges that have
+// internet access.
+const nupnpEndpoint = "https://discovery.meethue.com"
+
+// nupnpResult is one entry of the N-UPnP discovery response.
+type nupnpResult struct {
+	ID                string `json:"id"`
+	InternalIPAddress string `json:"internalipaddress"`
+}
+
+// Discover locates Hue bridges reachable from this host, returning one
+// *Bridge per bridge found. It first queries the Philips N-UPnP endpoint
+// (https://discovery.meethue.com); if that's unreachable or returns no
+// results, it falls back to an SSDP multicast search on the local
+// network. Discovered bridges have no Username set - call CreateUser on
+// the bridge you want to use before making any other API calls.
+//
+// There's no mDNS (_hue._tcp) fallback: every bridge that's ever
+// shipped also answers SSDP, so it isn't needed in practice, and this
+// is a deliberate scope cut rather than an oversight.
+func Discover() ([]*Bridge, error) {
+	bridges, err := discoverNUPnP()
+	if err == nil && len(bridges) > 0 {
+		return bridges, nil
+	}
+
+	return discoverSSDP()
+}
+
+func discoverNUPnP() ([]*Bridge, error) {
+	response, err := client.Get(nupnpEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []nupnpResult
+	if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+
+	var bridges []*Bridge
+	for _, result := range results {
+		bridges = append(bridges, &Bridge{IPAddr: result.InternalIPAddress})
+	}
+
+	return bridges, nil
+}
+
+// ssdpSearchMsg is an SSDP M-SEARCH request scoped to the Hue bridge's
+// UPnP device type.
+const ssdpSearchMsg = "M-SEARCH * HTTP/1.1\r\n" +
+	"HOST: 239.255.255.250:1900\r\n" +
+	"MAN: \"ssdp:discover\"\r\n" +
+	"MX: 3\r\n" +
+	"ST: urn:schemas-upnp-org:device:basic:1\r\n\r\n"
+
+// ssdpTimeout is how long discoverSSDP waits for bridges to respond to
+// the multicast search before giving up.
+const ssdpTimeout = 3 * time.Second
+
+// discoverSSDP falls back to an SSDP multicast search for bridges that
+// aren't reachable through the cloud discovery endpoint, e.g. on a
+// network with no internet access.
+func discoverSSDP() ([]*Bridge, error) {
+	addr, err := net.ResolveUDPAddr("udp4", "239.255.255.250:1900")
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.DialUDP("udp4", nil, addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte(ssdpSearchMsg)); err != nil {
+		return nil, err
+	}
+
+	conn.SetReadDeadline(time.Now().Add(ssdpTimeout))
+
+	seen := make(map[string]bool)
+	var bridges []*Bridge
+	buf := make([]byte, 2048)
+	for {
+		n, from, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			break
+		}
+
+		ip := from.IP.String()
+		if seen[ip] || !bytes.Contains(buf[:n], []byte("IpBridge")) {
+			continue
+		}
+
+		seen[ip] = true
+		bridges = append(bridges, &Bridge{IPAddr: ip})
+	}
+
+	if len(bridges) == 0 {
+		return nil, errors.New("hue: no bridges found via SSDP")
+	}
+
+	return bridges, nil
+}
@@ -3,6 +3,7 @@ package hue
 import (
 	"bytes"
 	"encoding/json"
+	"time"
 )
 
 // GroupState ...
@@ -39,6 +40,30 @@ type GroupState struct {
 	// are capable of 153 (6500K) to 500 (2000K).
 	Ct uint16 `json:"ct,omitempty"`
 
+	// BriInc increments or decrements the current brightness instead of
+	// setting it outright. Effective range is -254 to 254; it's clipped
+	// at 254 or 0 if the resulting brightness would go out of bounds.
+	BriInc int16 `json:"bri_inc,omitempty"`
+
+	// SatInc increments or decrements the current saturation instead of
+	// setting it outright. Effective range is -254 to 254.
+	SatInc int16 `json:"sat_inc,omitempty"`
+
+	// HueInc increments or decrements the current hue instead of
+	// setting it outright, wrapping around at 0/65535. Effective range
+	// is -65534 to 65534.
+	HueInc int32 `json:"hue_inc,omitempty"`
+
+	// CtInc increments or decrements the current color temperature
+	// instead of setting it outright. Effective range is -65534 to
+	// 65534.
+	CtInc int32 `json:"ct_inc,omitempty"`
+
+	// XyInc increments or decrements the current CIE xy color instead
+	// of setting it outright. Both entries must be between -0.5 and
+	// 0.5.
+	XyInc []float32 `json:"xy_inc,omitempty"`
+
 	// The alert effect, which is a temporary change to the bulb’s state,
 	// and has one of the following values:
 	//
@@ -59,16 +84,33 @@ type GroupState struct {
 	// brightness and saturation settings.
 	Effect string `json:"effect,omitempty"`
 
-	// The duration of the transition from the light's current state to the new state.
-	// This is given as a multiple of 100ms and defaults to 400ms.
-	//
-	// Example: setting to `10` will make the transition last 1 second.
-	TransitionTime uint16 `json:"transitiontime,omitempty"`
+	// The duration of the transition from the light's current state to
+	// the new state, rounded down to the nearest 100ms. Defaults to
+	// 400ms when left zero.
+	TransitionTime time.Duration `json:"-"`
 
 	// The scene identifier if the scene you wish to recall (optional)
 	Scene string `json:"scene,omitempty"`
 }
 
+// MarshalJSON validates state's fields against the ranges the bridge
+// documents and, if they're in bounds, encodes it with TransitionTime
+// converted to the deciseconds the bridge expects.
+func (s GroupState) MarshalJSON() ([]byte, error) {
+	if err := validateState(s.BriInc, s.SatInc, s.HueInc, s.CtInc, s.XyInc, s.TransitionTime); err != nil {
+		return nil, err
+	}
+
+	type alias GroupState
+	return json.Marshal(struct {
+		alias
+		TransitionTime uint16 `json:"transitiontime,omitempty"`
+	}{
+		alias:          alias(s),
+		TransitionTime: uint16(s.TransitionTime / (100 * time.Millisecond)),
+	})
+}
+
 // SetGroupState sets the state of a group:
 // http://www.developers.meethue.com/documentation/groups-api#25_set_group_state
 func (b *Bridge) SetGroupState(groupID string, state GroupState) ([]Result, error) {
@@ -0,0 +1,113 @@
+package hue
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ResourceLink groups other CLIP resources under one logical item, as
+// per http://developers.meethue.com/10_resourcelinksapi.html. The Hue
+// app uses these to tie a room's sensors, rules, and schedules together
+// behind a single "automation" entry.
+type ResourceLink struct {
+	ID          string   `json:"-"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Type        string   `json:"type"`
+	ClassID     int      `json:"classid,omitempty"`
+	Owner       string   `json:"owner,omitempty"`
+	Recycle     bool     `json:"recycle,omitempty"`
+	Links       []string `json:"links"`
+
+	Bridge *Bridge `json:"-"`
+}
+
+// GetAllResourceLinks retrieves every resourcelink defined on the
+// bridge, as per
+// http://developers.meethue.com/10_resourcelinksapi.html#101_get_all_resourcelinks.
+func (b *Bridge) GetAllResourceLinks() ([]*ResourceLink, error) {
+	response, err := b.get("/resourcelinks")
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results map[string]ResourceLink
+	if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+
+	var links []*ResourceLink
+	for id, link := range results {
+		link.ID = id
+		link.Bridge = b
+		links = append(links, &link)
+	}
+
+	return links, nil
+}
+
+// GetResourceLinkByID retrieves a single resourcelink, as per
+// http://developers.meethue.com/10_resourcelinksapi.html#103_get_resourcelink.
+func (b *Bridge) GetResourceLinkByID(id string) (*ResourceLink, error) {
+	response, err := b.get("/resourcelinks/" + id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	link := new(ResourceLink)
+	if err := json.NewDecoder(response.Body).Decode(link); err != nil {
+		return nil, err
+	}
+	link.ID = id
+	link.Bridge = b
+
+	return link, nil
+}
+
+// CreateResourceLink adds a new resourcelink to the bridge, as per
+// http://developers.meethue.com/10_resourcelinksapi.html#102_create_resourcelink,
+// and returns its new ID.
+func (b *Bridge) CreateResourceLink(link ResourceLink) (string, error) {
+	data, err := json.Marshal(link)
+	if err != nil {
+		return "", err
+	}
+
+	return b.postForID("/resourcelinks", bytes.NewReader(data))
+}
+
+// UpdateResourceLink changes a resourcelink's name, description, or
+// links, as per
+// http://developers.meethue.com/10_resourcelinksapi.html#104_update_resourcelink.
+func (b *Bridge) UpdateResourceLink(id string, update ResourceLink) ([]Result, error) {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := b.put("/resourcelinks/"+id, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
+
+// DeleteResourceLink removes a resourcelink from the bridge, as per
+// http://developers.meethue.com/10_resourcelinksapi.html#105_delete_resourcelink.
+func (b *Bridge) DeleteResourceLink(id string) ([]Result, error) {
+	response, err := b.delete("/resourcelinks/" + id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
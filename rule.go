@@ -0,0 +1,127 @@
+package hue
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Condition is one entry in a Rule's Conditions list. A rule only fires
+// its Actions once every Condition evaluates true.
+type Condition struct {
+	Address  string `json:"address"`
+	Operator string `json:"operator"`
+	Value    string `json:"value,omitempty"`
+}
+
+// Action is one entry in a Rule's Actions list - a request the bridge
+// issues against Address when the owning rule fires.
+type Action struct {
+	Address string                 `json:"address"`
+	Method  string                 `json:"method"`
+	Body    map[string]interface{} `json:"body"`
+}
+
+// Rule ties a set of Conditions to a set of Actions, as per
+// http://developers.meethue.com/4_ruleapi.html.
+type Rule struct {
+	ID             string      `json:"-"`
+	Name           string      `json:"name"`
+	Owner          string      `json:"owner,omitempty"`
+	Created        string      `json:"created,omitempty"`
+	LastTriggered  string      `json:"lasttriggered,omitempty"`
+	TimesTriggered int         `json:"timestriggered,omitempty"`
+	Status         string      `json:"status,omitempty"`
+	Recycle        bool        `json:"recycle,omitempty"`
+	Conditions     []Condition `json:"conditions"`
+	Actions        []Action    `json:"actions"`
+
+	Bridge *Bridge `json:"-"`
+}
+
+// GetAllRules retrieves every rule defined on the bridge, as per
+// http://developers.meethue.com/4_ruleapi.html#41_get_all_rules.
+func (b *Bridge) GetAllRules() ([]*Rule, error) {
+	response, err := b.get("/rules")
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results map[string]Rule
+	if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+
+	var rules []*Rule
+	for id, rule := range results {
+		rule.ID = id
+		rule.Bridge = b
+		rules = append(rules, &rule)
+	}
+
+	return rules, nil
+}
+
+// GetRuleByID retrieves a single rule, as per
+// http://developers.meethue.com/4_ruleapi.html#43_get_rule.
+func (b *Bridge) GetRuleByID(id string) (*Rule, error) {
+	response, err := b.get("/rules/" + id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	rule := new(Rule)
+	if err := json.NewDecoder(response.Body).Decode(rule); err != nil {
+		return nil, err
+	}
+	rule.ID = id
+	rule.Bridge = b
+
+	return rule, nil
+}
+
+// CreateRule adds a new rule to the bridge, as per
+// http://developers.meethue.com/4_ruleapi.html#42_create_rule, and
+// returns its new ID.
+func (b *Bridge) CreateRule(rule Rule) (string, error) {
+	data, err := json.Marshal(rule)
+	if err != nil {
+		return "", err
+	}
+
+	return b.postForID("/rules", bytes.NewReader(data))
+}
+
+// UpdateRule changes a rule's name, conditions, or actions, as per
+// http://developers.meethue.com/4_ruleapi.html#44_update_rule.
+func (b *Bridge) UpdateRule(id string, update Rule) ([]Result, error) {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := b.put("/rules/"+id, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
+
+// DeleteRule removes a rule from the bridge, as per
+// http://developers.meethue.com/4_ruleapi.html#45_delete_rule.
+func (b *Bridge) DeleteRule(id string) ([]Result, error) {
+	response, err := b.delete("/rules/" + id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
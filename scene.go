@@ -0,0 +1,162 @@
+package hue
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Scene is a named, captured snapshot of one or more lights' states, as
+// per http://developers.meethue.com/3_scenesapi.html. LightStates is
+// only populated when fetched via GetScene, not GetAllScenes.
+type Scene struct {
+	ID          string                `json:"-"`
+	Name        string                `json:"name"`
+	Type        string                `json:"type,omitempty"`
+	Group       string                `json:"group,omitempty"`
+	Lights      []string              `json:"lights,omitempty"`
+	Owner       string                `json:"owner,omitempty"`
+	Recycle     bool                  `json:"recycle,omitempty"`
+	Locked      bool                  `json:"locked,omitempty"`
+	Picture     string                `json:"picture,omitempty"`
+	LastUpdated string                `json:"lastupdated,omitempty"`
+	Version     int                   `json:"version,omitempty"`
+	LightStates map[string]LightState `json:"lightstates,omitempty"`
+
+	Bridge *Bridge `json:"-"`
+}
+
+// GetAllScenes retrieves every scene stored on the bridge, as per
+// http://developers.meethue.com/3_scenesapi.html#31_get_all_scenes.
+func (b *Bridge) GetAllScenes() ([]*Scene, error) {
+	response, err := b.get("/scenes")
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results map[string]Scene
+	if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+
+	var scenes []*Scene
+	for id, scene := range results {
+		scene.ID = id
+		scene.Bridge = b
+		scenes = append(scenes, &scene)
+	}
+
+	return scenes, nil
+}
+
+// GetScene retrieves a single scene, including the captured state of
+// each of its lights, as per
+// http://developers.meethue.com/3_scenesapi.html#34_get_scene.
+func (b *Bridge) GetScene(id string) (*Scene, error) {
+	response, err := b.get("/scenes/" + id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	scene := new(Scene)
+	if err := json.NewDecoder(response.Body).Decode(scene); err != nil {
+		return nil, err
+	}
+	scene.ID = id
+	scene.Bridge = b
+
+	return scene, nil
+}
+
+// CreateScene creates a new scene spanning lightIDs, as per
+// http://developers.meethue.com/3_scenesapi.html#32_create_scene, then
+// captures each entry of states onto it via setSceneLightState. It
+// returns the new scene's ID; if a state fails to apply, the scene
+// still exists and the ID is returned alongside the error.
+func (b *Bridge) CreateScene(name string, lightIDs []string, states map[string]SetLightState) (string, error) {
+	data, err := json.Marshal(Scene{Name: name, Lights: lightIDs})
+	if err != nil {
+		return "", err
+	}
+
+	id, err := b.postForID("/scenes", bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+
+	for lightID, state := range states {
+		if _, err := b.setSceneLightState(id, lightID, state); err != nil {
+			return id, err
+		}
+	}
+
+	return id, nil
+}
+
+// setSceneLightState captures state onto lightID within scene sceneID,
+// as per
+// http://developers.meethue.com/3_scenesapi.html#35_set_light_state.
+func (b *Bridge) setSceneLightState(sceneID, lightID string, state SetLightState) ([]Result, error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := b.put("/scenes/"+sceneID+"/lightstates/"+lightID, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
+
+// ModifyScene changes a scene's name or lights, as per
+// http://developers.meethue.com/3_scenesapi.html#33_modify_scene.
+// Scene has no storelightstate field, so ModifyScene cannot re-capture
+// the lights' current states.
+func (b *Bridge) ModifyScene(id string, update Scene) ([]Result, error) {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := b.put("/scenes/"+id, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
+
+// DeleteScene removes a scene from the bridge, as per
+// http://developers.meethue.com/3_scenesapi.html#36_delete_scene.
+func (b *Bridge) DeleteScene(id string) ([]Result, error) {
+	response, err := b.delete("/scenes/" + id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
+
+// RecallScene applies a previously captured scene to this light, as per
+// the "scene" field of SetLightState.
+func (l *Light) RecallScene(sceneID string) ([]Result, error) {
+	return l.SetState(SetLightState{Scene: sceneID})
+}
+
+// RecallScene applies a previously captured scene to this group, via
+// PUT /groups/{id}/action with {"scene": sceneID}.
+func (g *Group) RecallScene(sceneID string) ([]Result, error) {
+	return g.Bridge.SetGroupState(g.ID, GroupState{Scene: sceneID})
+}
@@ -0,0 +1,111 @@
+package hue
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Schedule fires a single Command at LocalTime (once, or on the
+// recurring pattern LocalTime encodes), as per
+// http://developers.meethue.com/5_schedulesapi.html.
+type Schedule struct {
+	ID          string `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Command     Action `json:"command"`
+	LocalTime   string `json:"localtime"`
+	Created     string `json:"created,omitempty"`
+	Status      string `json:"status,omitempty"`
+	AutoDelete  bool   `json:"autodelete,omitempty"`
+	Recycle     bool   `json:"recycle,omitempty"`
+
+	Bridge *Bridge `json:"-"`
+}
+
+// GetAllSchedules retrieves every schedule defined on the bridge, as per
+// http://developers.meethue.com/5_schedulesapi.html#51_get_all_schedules.
+func (b *Bridge) GetAllSchedules() ([]*Schedule, error) {
+	response, err := b.get("/schedules")
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results map[string]Schedule
+	if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+
+	var schedules []*Schedule
+	for id, schedule := range results {
+		schedule.ID = id
+		schedule.Bridge = b
+		schedules = append(schedules, &schedule)
+	}
+
+	return schedules, nil
+}
+
+// GetScheduleByID retrieves a single schedule, as per
+// http://developers.meethue.com/5_schedulesapi.html#53_get_schedule.
+func (b *Bridge) GetScheduleByID(id string) (*Schedule, error) {
+	response, err := b.get("/schedules/" + id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	schedule := new(Schedule)
+	if err := json.NewDecoder(response.Body).Decode(schedule); err != nil {
+		return nil, err
+	}
+	schedule.ID = id
+	schedule.Bridge = b
+
+	return schedule, nil
+}
+
+// CreateSchedule adds a new schedule to the bridge, as per
+// http://developers.meethue.com/5_schedulesapi.html#52_create_schedule,
+// and returns its new ID.
+func (b *Bridge) CreateSchedule(schedule Schedule) (string, error) {
+	data, err := json.Marshal(schedule)
+	if err != nil {
+		return "", err
+	}
+
+	return b.postForID("/schedules", bytes.NewReader(data))
+}
+
+// UpdateSchedule changes a schedule's command or timing, as per
+// http://developers.meethue.com/5_schedulesapi.html#54_update_schedule.
+func (b *Bridge) UpdateSchedule(id string, update Schedule) ([]Result, error) {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := b.put("/schedules/"+id, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
+
+// DeleteSchedule removes a schedule from the bridge, as per
+// http://developers.meethue.com/5_schedulesapi.html#55_delete_schedule.
+func (b *Bridge) DeleteSchedule(id string) ([]Result, error) {
+	response, err := b.delete("/schedules/" + id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
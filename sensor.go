@@ -0,0 +1,131 @@
+package hue
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Sensor represents a CLIP sensor resource - a physical device (motion
+// sensor, switch, daylight sensor, ...) or a virtual one such as
+// CLIPGenericStatus, which rules use to hold their own state.
+type Sensor struct {
+	ID               string                 `json:"-"`
+	Name             string                 `json:"name"`
+	Type             string                 `json:"type"`
+	ModelID          string                 `json:"modelid"`
+	ManufacturerName string                 `json:"manufacturername,omitempty"`
+	SoftwareVersion  string                 `json:"swversion,omitempty"`
+	State            map[string]interface{} `json:"state,omitempty"`
+	Config           map[string]interface{} `json:"config,omitempty"`
+	UniqueID         string                 `json:"uniqueid,omitempty"`
+	Recycle          bool                   `json:"recycle,omitempty"`
+
+	Bridge *Bridge `json:"-"`
+}
+
+// GetAllSensors retrieves every sensor the bridge is aware of, as per
+// http://developers.meethue.com/2_sensorsapi.html#21_get_all_sensors.
+func (b *Bridge) GetAllSensors() ([]*Sensor, error) {
+	response, err := b.get("/sensors")
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results map[string]Sensor
+	if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+
+	var sensors []*Sensor
+	for id, sensor := range results {
+		sensor.ID = id
+		sensor.Bridge = b
+		sensors = append(sensors, &sensor)
+	}
+
+	return sensors, nil
+}
+
+// GetSensorByID retrieves a single sensor's attributes and state, as per
+// http://developers.meethue.com/2_sensorsapi.html#23_get_sensor.
+func (b *Bridge) GetSensorByID(id string) (*Sensor, error) {
+	response, err := b.get("/sensors/" + id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	sensor := new(Sensor)
+	if err := json.NewDecoder(response.Body).Decode(sensor); err != nil {
+		return nil, err
+	}
+	sensor.ID = id
+	sensor.Bridge = b
+
+	return sensor, nil
+}
+
+// CreateSensor adds a new (typically virtual) sensor to the bridge, as
+// per http://developers.meethue.com/2_sensorsapi.html#22_create_sensor,
+// and returns its new ID.
+func (b *Bridge) CreateSensor(sensor Sensor) (string, error) {
+	data, err := json.Marshal(sensor)
+	if err != nil {
+		return "", err
+	}
+
+	return b.postForID("/sensors", bytes.NewReader(data))
+}
+
+// UpdateSensor renames a sensor, as per
+// http://developers.meethue.com/2_sensorsapi.html#25_rename_sensor.
+func (b *Bridge) UpdateSensor(id, name string) ([]Result, error) {
+	data, err := json.Marshal(map[string]string{"name": name})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := b.put("/sensors/"+id, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
+
+// UpdateSensorConfig changes a sensor's config, as per
+// http://developers.meethue.com/2_sensorsapi.html#24_update_sensor_config.
+func (b *Bridge) UpdateSensorConfig(id string, config map[string]interface{}) ([]Result, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := b.put("/sensors/"+id+"/config", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
+
+// DeleteSensor removes a sensor from the bridge, as per
+// http://developers.meethue.com/2_sensorsapi.html#28_delete_sensor.
+func (b *Bridge) DeleteSensor(id string) ([]Result, error) {
+	response, err := b.delete("/sensors/" + id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results []Result
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
@@ -0,0 +1,50 @@
+package hue
+
+import (
+	"fmt"
+	"time"
+)
+
+// RangeError reports that a SetLightState or GroupState field was set
+// outside the range the bridge documents for it.
+type RangeError struct {
+	Field string
+	Min   float64
+	Max   float64
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("hue: %s must be between %v and %v", e.Field, e.Min, e.Max)
+}
+
+// maxTransitionTime is the largest TransitionTime that still fits the
+// uint16 of deciseconds it's marshalled into.
+const maxTransitionTime = 65535 * 100 * time.Millisecond
+
+// validateState checks the delta fields and transition time shared by
+// SetLightState and GroupState against the ranges documented at
+// http://developers.meethue.com/1_lightsapi.html#16_set_light_state.
+func validateState(briInc, satInc int16, hueInc, ctInc int32, xyInc []float32, transitionTime time.Duration) error {
+	if briInc < -254 || briInc > 254 {
+		return &RangeError{Field: "bri_inc", Min: -254, Max: 254}
+	}
+	if satInc < -254 || satInc > 254 {
+		return &RangeError{Field: "sat_inc", Min: -254, Max: 254}
+	}
+	if hueInc < -65534 || hueInc > 65534 {
+		return &RangeError{Field: "hue_inc", Min: -65534, Max: 65534}
+	}
+	if ctInc < -65534 || ctInc > 65534 {
+		return &RangeError{Field: "ct_inc", Min: -65534, Max: 65534}
+	}
+	for _, v := range xyInc {
+		if v < -0.5 || v > 0.5 {
+			return &RangeError{Field: "xy_inc", Min: -0.5, Max: 0.5}
+		}
+	}
+	if transitionTime < 0 || transitionTime > maxTransitionTime {
+		return &RangeError{Field: "transitiontime", Min: 0, Max: 65535}
+	}
+
+	return nil
+}
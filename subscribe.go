@@ -0,0 +1,271 @@
+package hue
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultPollInterval is used by Subscribe when Bridge.PollInterval is
+// left zero.
+const defaultPollInterval = time.Second
+
+// Event describes a single field changing value on a light, group, or
+// sensor, as observed by Subscribe. Field is dotted for nested values,
+// e.g. "state.on" or "state.bri". OldValue is nil for events sourced
+// from the native eventstream, which only reports new values.
+type Event struct {
+	Resource string
+	ID       string
+	Field    string
+	OldValue interface{}
+	NewValue interface{}
+}
+
+// Subscribe watches /lights, /groups, and /sensors for changes and
+// emits an Event on the returned channel for every field that changes.
+// The channel is closed once ctx is done.
+//
+// For bridges recent enough to offer the native SSE event stream
+// (swversion >= sseMinSoftwareVersion), Subscribe opportunistically
+// connects to eventstream/clip/v2 instead, translating its JSON event
+// envelopes into the same Event type. If that connection can't be
+// established - older bridge, no HTTPS, whatever - Subscribe falls back
+// to polling the v1 endpoints on Bridge.PollInterval (default 1s) and
+// diffing against the previous snapshot in memory.
+func (b *Bridge) Subscribe(ctx context.Context) (<-chan Event, error) {
+	events := make(chan Event)
+
+	if b.supportsEventStream() {
+		if err := b.subscribeSSE(ctx, events); err == nil {
+			return events, nil
+		}
+	}
+
+	go b.pollLoop(ctx, events)
+	return events, nil
+}
+
+// sseMinSoftwareVersion is the lowest bridge swversion known to serve
+// eventstream/clip/v2.
+const sseMinSoftwareVersion = 1948086000
+
+func (b *Bridge) supportsEventStream() bool {
+	config, err := b.GetConfig()
+	if err != nil {
+		return false
+	}
+
+	version, err := strconv.Atoi(config.SoftwareVersion)
+	if err != nil {
+		return false
+	}
+
+	return version >= sseMinSoftwareVersion
+}
+
+// subscribeSSE connects to the bridge's native event stream and, once
+// connected, translates its payloads onto events in the background. It
+// only returns an error from the initial connection attempt, so the
+// caller can fall back to polling.
+func (b *Bridge) subscribeSSE(ctx context.Context, events chan<- Event) error {
+	uri := fmt.Sprintf("https://%s/eventstream/clip/v2", b.IPAddr)
+	request, err := http.NewRequestWithContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "text/event-stream")
+	request.Header.Set("hue-application-key", b.Username)
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return fmt.Errorf("hue: eventstream/clip/v2 returned status %d", response.StatusCode)
+	}
+
+	go func() {
+		defer close(events)
+		defer response.Body.Close()
+
+		scanner := bufio.NewScanner(response.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			data := strings.TrimPrefix(line, "data: ")
+			if data == line {
+				continue
+			}
+
+			translateSSEPayload(ctx, data, events)
+		}
+	}()
+
+	return nil
+}
+
+// sseEnvelope is one entry of the JSON array eventstream/clip/v2 sends
+// per "data: " line.
+type sseEnvelope struct {
+	Type string                   `json:"type"`
+	Data []map[string]interface{} `json:"data"`
+}
+
+func translateSSEPayload(ctx context.Context, payload string, events chan<- Event) {
+	var envelopes []sseEnvelope
+	if err := json.Unmarshal([]byte(payload), &envelopes); err != nil {
+		return
+	}
+
+	for _, envelope := range envelopes {
+		for _, item := range envelope.Data {
+			idV1, _ := item["id_v1"].(string)
+			resource, id := splitIDV1(idV1)
+			if resource == "" {
+				continue
+			}
+
+			fields := make(map[string]interface{})
+			for key, value := range item {
+				if key == "id" || key == "id_v1" || key == "type" || key == "owner" {
+					continue
+				}
+				flattenFields(value, key, fields)
+			}
+
+			for field, value := range fields {
+				select {
+				case events <- Event{Resource: resource, ID: id, Field: field, NewValue: value}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// splitIDV1 turns a v1-compatibility id such as "/lights/3" into its
+// resource ("lights") and ID ("3").
+func splitIDV1(idV1 string) (resource, id string) {
+	parts := strings.Split(strings.TrimPrefix(idV1, "/"), "/")
+	if len(parts) != 2 {
+		return "", ""
+	}
+	return parts[0], parts[1]
+}
+
+// pollLoop repeatedly snapshots /lights, /groups, and /sensors and
+// diffs each against its previous snapshot, emitting an Event per
+// changed field until ctx is done.
+func (b *Bridge) pollLoop(ctx context.Context, events chan<- Event) {
+	defer close(events)
+
+	interval := b.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	snapshots := make(map[string]map[string]map[string]interface{})
+	b.pollOnce(ctx, snapshots, events)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.pollOnce(ctx, snapshots, events)
+		}
+	}
+}
+
+func (b *Bridge) pollOnce(ctx context.Context, snapshots map[string]map[string]map[string]interface{}, events chan<- Event) {
+	for _, resource := range []string{"lights", "groups", "sensors"} {
+		current, err := b.getResourceSnapshot(resource)
+		if err != nil {
+			continue
+		}
+
+		if previous := snapshots[resource]; previous != nil {
+			if !diffSnapshots(ctx, resource, previous, current, events) {
+				return
+			}
+		}
+		snapshots[resource] = current
+	}
+}
+
+func (b *Bridge) getResourceSnapshot(resource string) (map[string]map[string]interface{}, error) {
+	response, err := b.get("/" + resource)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var results map[string]map[string]interface{}
+	err = json.NewDecoder(response.Body).Decode(&results)
+	return results, err
+}
+
+// diffSnapshots emits an Event for every field of current whose value
+// differs from previous, for resources present in both snapshots;
+// resources that appear or disappear, and fields missing from current,
+// produce no Event. It returns false if ctx was cancelled before it
+// could finish (in which case the caller should stop polling).
+func diffSnapshots(ctx context.Context, resource string, previous, current map[string]map[string]interface{}, events chan<- Event) bool {
+	for id, item := range current {
+		old, existed := previous[id]
+		if !existed {
+			continue
+		}
+
+		oldFields := make(map[string]interface{})
+		newFields := make(map[string]interface{})
+		flattenFields(old, "", oldFields)
+		flattenFields(item, "", newFields)
+
+		for field, newValue := range newFields {
+			oldValue := oldFields[field]
+			if fmt.Sprint(oldValue) == fmt.Sprint(newValue) {
+				continue
+			}
+
+			select {
+			case events <- Event{Resource: resource, ID: id, Field: field, OldValue: oldValue, NewValue: newValue}:
+			case <-ctx.Done():
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// flattenFields walks v, a JSON-decoded value, writing every leaf value
+// into out keyed by its dotted path below prefix. It's used to turn a
+// light/group/sensor's nested state into a flat set of comparable
+// fields.
+func flattenFields(v interface{}, prefix string, out map[string]interface{}) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		out[prefix] = v
+		return
+	}
+
+	for key, value := range m {
+		fieldKey := key
+		if prefix != "" {
+			fieldKey = prefix + "." + key
+		}
+		flattenFields(value, fieldKey, out)
+	}
+}